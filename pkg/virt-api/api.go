@@ -0,0 +1,55 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2026 Red Hat, Inc.
+ *
+ */
+
+package virt_api
+
+import (
+	restful "github.com/emicklei/go-restful/v3"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+
+	"kubevirt.io/api/migrations"
+
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	mutatingwebhookadmitters "kubevirt.io/kubevirt/pkg/virt-api/webhooks/mutating-webhook/admitters"
+)
+
+// registerMigrationPolicyMutatingWebhook adds the MigrationPolicy defaulting webhook to the set of
+// mutating webhooks virt-api registers with the apiserver, alongside the existing VMI/VM defaulting
+// webhooks.
+func (app *virtAPIApp) registerMigrationPolicyMutatingWebhook(webhooksList []admissionregistrationv1.MutatingWebhook) []admissionregistrationv1.MutatingWebhook {
+	mutator := mutatingwebhookadmitters.NewMigrationPolicyMutator(app.clusterConfig)
+	app.mutatingWebhookMutators[migrations.ResourceMigrationPolicies] = mutator.Mutate
+
+	return append(webhooksList, admissionregistrationv1.MutatingWebhook{
+		Name: "migration-policy-mutator.kubevirt.io",
+	})
+}
+
+// registerMigrationPolicyEffectiveRoute adds the migrationpolicies/effective preview subresource to
+// the virt-api REST WebService, so callers can preview the merged MigrationPolicy for a VMI without
+// creating or editing a policy.
+func (app *virtAPIApp) registerMigrationPolicyEffectiveRoute(ws *restful.WebService) {
+	effectiveApp := webhooks.NewMigrationPolicyEffectiveApp(app.virtCli)
+
+	ws.Route(ws.POST("/apis/migrations.kubevirt.io/v1alpha1/namespaces/{namespace}/migrationpolicies/effective").
+		To(effectiveApp.Handle).
+		Reads(webhooks.EffectivePolicyRequest{}).
+		Writes(webhooks.EffectivePolicyResponse{}).
+		Doc("Preview the MigrationPolicy that would apply to a VMI with the given labels."))
+}
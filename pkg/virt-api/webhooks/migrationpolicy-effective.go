@@ -0,0 +1,172 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2026 Red Hat, Inc.
+ *
+ */
+
+package webhooks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+)
+
+// EffectivePolicyRequest is the body accepted by the migrationpolicies/effective subresource. It
+// carries just enough to evaluate MigrationPolicy selectors against: the would-be VMI's own labels,
+// plus the labels of the namespace it would live in. NamespaceLabels is optional; when omitted, the
+// labels of the {namespace} path parameter are looked up instead.
+type EffectivePolicyRequest struct {
+	Labels          map[string]string `json:"labels,omitempty"`
+	NamespaceLabels map[string]string `json:"namespaceLabels,omitempty"`
+}
+
+// EffectivePolicyResponse returns the MigrationPolicy that would be applied to a VMI matching the
+// request, or nil if no policy matches.
+type EffectivePolicyResponse struct {
+	Policy *migrationsv1.MigrationPolicySpec `json:"policy,omitempty"`
+}
+
+// MigrationPolicyEffectiveApp serves the migrationpolicies/effective subresource, letting callers
+// preview the merged MigrationPolicy that would apply to a given VMI before creating or editing a
+// policy, without that preview actually admitting anything.
+type MigrationPolicyEffectiveApp struct {
+	Client kubecli.KubevirtClient
+}
+
+// NewMigrationPolicyEffectiveApp creates a MigrationPolicyEffectiveApp.
+func NewMigrationPolicyEffectiveApp(client kubecli.KubevirtClient) *MigrationPolicyEffectiveApp {
+	return &MigrationPolicyEffectiveApp{
+		Client: client,
+	}
+}
+
+// Handle implements the go-restful route function for
+// /apis/migrations.kubevirt.io/v1alpha1/namespaces/{namespace}/migrationpolicies/effective.
+func (app *MigrationPolicyEffectiveApp) Handle(request *restful.Request, response *restful.Response) {
+	namespace := request.PathParameter("namespace")
+
+	effectiveRequest := &EffectivePolicyRequest{}
+	if err := request.ReadEntity(effectiveRequest); err != nil {
+		log.Log.Reason(err).Error("failed to decode EffectivePolicyRequest")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	namespaceLabels := effectiveRequest.NamespaceLabels
+	if namespaceLabels == nil {
+		ns, err := app.Client.CoreV1().Namespaces().Get(context.Background(), namespace, metav1.GetOptions{})
+		if err != nil {
+			log.Log.Reason(err).Errorf("failed to look up namespace %q", namespace)
+			response.WriteError(http.StatusInternalServerError, err)
+			return
+		}
+		namespaceLabels = ns.Labels
+	}
+
+	policies, err := app.Client.MigrationPolicy().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		log.Log.Reason(err).Error("failed to list MigrationPolicy objects")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	effective := matchEffectivePolicy(namespaceLabels, effectiveRequest.Labels, policies.Items)
+
+	effectiveResponse := &EffectivePolicyResponse{}
+	if effective != nil {
+		effectiveResponse.Policy = &effective.Spec
+	}
+	response.WriteEntity(effectiveResponse)
+}
+
+// matchEffectivePolicy selects the MigrationPolicy that best matches the given labels, preferring
+// the policy whose selectors are the most specific (see policyPrecedenceTier). When several policies
+// at the same tier match, the first one encountered is returned. The overlap-rejection check in
+// MigrationPolicyAdmitter rejects same-tier policies whose matchLabels are equal or nested, but
+// same-tier policies whose matchLabels only intersect, or that have no selectors at all, are admitted
+// and can both match; the first one listed then wins.
+func matchEffectivePolicy(namespaceLabels, vmiLabels map[string]string, policies []migrationsv1.MigrationPolicy) *migrationsv1.MigrationPolicy {
+	var best *migrationsv1.MigrationPolicy
+	bestTier := -1
+
+	for i := range policies {
+		policy := &policies[i]
+		if !selectorsMatch(policy.Spec.Selectors, namespaceLabels, vmiLabels) {
+			continue
+		}
+		tier := policyPrecedenceTier(policy.Spec)
+		if tier > bestTier {
+			best = policy
+			bestTier = tier
+		}
+	}
+
+	return best
+}
+
+// policyPrecedenceTier ranks a MigrationPolicy by selector specificity: one point each for a
+// namespace selector and a VMI selector, so 0 (no selectors) to 2 (both).
+func policyPrecedenceTier(spec migrationsv1.MigrationPolicySpec) int {
+	tier := 0
+	if spec.Selectors == nil {
+		return tier
+	}
+	if spec.Selectors.NamespaceSelector != nil {
+		tier++
+	}
+	if spec.Selectors.VirtualMachineInstanceSelector != nil {
+		tier++
+	}
+	return tier
+}
+
+// selectorsMatch reports whether the given namespace and VMI labels satisfy a policy's selectors. A
+// nil selector matches everything.
+func selectorsMatch(selectors *migrationsv1.Selectors, namespaceLabels, vmiLabels map[string]string) bool {
+	if selectors == nil {
+		return true
+	}
+	if !labelSelectorMatches(selectors.NamespaceSelector, namespaceLabels) {
+		return false
+	}
+	if !labelSelectorMatches(selectors.VirtualMachineInstanceSelector, vmiLabels) {
+		return false
+	}
+	return true
+}
+
+func labelSelectorMatches(selector *metav1.LabelSelector, labels map[string]string) bool {
+	if selector == nil {
+		return true
+	}
+	for k, v := range selector.MatchLabels {
+		if labels[k] != v {
+			return false
+		}
+	}
+	// MatchExpressions aren't evaluated here: the request only carries plain labels, so a selector
+	// that uses matchExpressions never matches.
+	return len(selector.MatchExpressions) == 0
+}
@@ -0,0 +1,82 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2026 Red Hat, Inc.
+ *
+ */
+
+package webhooks
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+)
+
+var _ = Describe("MigrationPolicy effective-policy matching", func() {
+	withSelectors := func(name string, selectors *migrationsv1.Selectors) migrationsv1.MigrationPolicy {
+		return migrationsv1.MigrationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       migrationsv1.MigrationPolicySpec{Selectors: selectors},
+		}
+	}
+
+	It("returns nil when no policy matches", func() {
+		policies := []migrationsv1.MigrationPolicy{
+			withSelectors("prod-only", &migrationsv1.Selectors{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			}),
+		}
+		Expect(matchEffectivePolicy(map[string]string{"env": "staging"}, nil, policies)).To(BeNil())
+	})
+
+	It("prefers the more specific (higher-tier) matching policy", func() {
+		broad := withSelectors("broad", &migrationsv1.Selectors{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+		})
+		specific := withSelectors("specific", &migrationsv1.Selectors{
+			NamespaceSelector:              &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			VirtualMachineInstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "db"}},
+		})
+
+		namespaceLabels := map[string]string{"env": "prod"}
+		vmiLabels := map[string]string{"tier": "db"}
+
+		effective := matchEffectivePolicy(namespaceLabels, vmiLabels, []migrationsv1.MigrationPolicy{broad, specific})
+		Expect(effective).ToNot(BeNil())
+		Expect(effective.Name).To(Equal("specific"))
+	})
+
+	It("matches a policy with no selectors against any labels", func() {
+		policies := []migrationsv1.MigrationPolicy{withSelectors("catch-all", nil)}
+		effective := matchEffectivePolicy(map[string]string{"env": "staging"}, map[string]string{"tier": "web"}, policies)
+		Expect(effective).ToNot(BeNil())
+		Expect(effective.Name).To(Equal("catch-all"))
+	})
+
+	DescribeTable("labelSelectorMatches", func(selector *metav1.LabelSelector, labels map[string]string, expected bool) {
+		Expect(labelSelectorMatches(selector, labels)).To(Equal(expected))
+	},
+		Entry("nil selector matches anything", nil, map[string]string{"a": "b"}, true),
+		Entry("matching matchLabels", &metav1.LabelSelector{MatchLabels: map[string]string{"a": "b"}}, map[string]string{"a": "b"}, true),
+		Entry("mismatched matchLabels", &metav1.LabelSelector{MatchLabels: map[string]string{"a": "b"}}, map[string]string{"a": "c"}, false),
+		Entry("matchExpressions are treated as unverifiable",
+			&metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "a", Operator: metav1.LabelSelectorOpExists}}},
+			map[string]string{"a": "b"}, false),
+	)
+})
@@ -0,0 +1,119 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2026 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+
+	"kubevirt.io/api/migrations"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+)
+
+// MigrationPolicyMutator defaults unset MigrationPolicy.Spec fields from the KubeVirt CR's
+// MigrationConfiguration, mirroring the defaulting applied to VMIs. This lets tenants create minimal
+// MigrationPolicy objects while still getting deterministic behavior.
+type MigrationPolicyMutator struct {
+	ClusterConfig *virtconfig.ClusterConfig
+}
+
+// NewMigrationPolicyMutator creates a MigrationPolicyMutator
+func NewMigrationPolicyMutator(clusterConfig *virtconfig.ClusterConfig) *MigrationPolicyMutator {
+	return &MigrationPolicyMutator{
+		ClusterConfig: clusterConfig,
+	}
+}
+
+// Mutate defaults a MigrationPolicy's spec on Create from the cluster's MigrationConfiguration
+func (mutator *MigrationPolicyMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != migrationsv1.MigrationPolicyKind.Group ||
+		ar.Request.Resource.Resource != migrations.ResourceMigrationPolicies {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if !mutator.ClusterConfig.MigrationPolicyDefaultingEnabled() {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	if ar.Request.Operation != admissionv1.Create {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	policy := &migrationsv1.MigrationPolicy{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, policy); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	defaults := mutator.ClusterConfig.GetMigrationConfiguration()
+
+	var patches []map[string]interface{}
+	spec := &policy.Spec
+
+	if spec.BandwidthPerMigration == nil && defaults.BandwidthPerMigration != nil {
+		patches = append(patches, addOp("/spec/bandwidthPerMigration", defaults.BandwidthPerMigration))
+	}
+	if spec.CompletionTimeoutPerGiB == nil && defaults.CompletionTimeoutPerGiB != nil {
+		patches = append(patches, addOp("/spec/completionTimeoutPerGiB", defaults.CompletionTimeoutPerGiB))
+	}
+	if spec.AllowPostCopy == nil && defaults.AllowPostCopy != nil {
+		patches = append(patches, addOp("/spec/allowPostCopy", defaults.AllowPostCopy))
+	}
+	if spec.AllowAutoConverge == nil && defaults.AllowAutoConverge != nil {
+		patches = append(patches, addOp("/spec/allowAutoConverge", defaults.AllowAutoConverge))
+	}
+	if spec.ParallelMigrationsPerCluster == nil && defaults.ParallelMigrationsPerCluster != nil {
+		patches = append(patches, addOp("/spec/parallelMigrationsPerCluster", defaults.ParallelMigrationsPerCluster))
+	}
+	if spec.ParallelOutboundMigrationsPerNode == nil && defaults.ParallelOutboundMigrationsPerNode != nil {
+		patches = append(patches, addOp("/spec/parallelOutboundMigrationsPerNode", defaults.ParallelOutboundMigrationsPerNode))
+	}
+
+	if len(patches) == 0 {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	patchBytes, err := json.Marshal(patches)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	jsonPatch := admissionv1.PatchTypeJSONPatch
+	return &admissionv1.AdmissionResponse{
+		Allowed:   true,
+		Patch:     patchBytes,
+		PatchType: &jsonPatch,
+	}
+}
+
+// addOp builds a single JSON Patch "add" operation. "add" is used instead of "replace" since the
+// target field is unset on the incoming object, and "replace" requires the field to already exist.
+func addOp(path string, value interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"op":    "add",
+		"path":  path,
+		"value": value,
+	}
+}
@@ -0,0 +1,133 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2026 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/migrations"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+func TestMigrationPolicyMutator(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "MigrationPolicyMutator Suite")
+}
+
+var _ = Describe("MigrationPolicyMutator", func() {
+	admissionReviewFor := func(policy *migrationsv1.MigrationPolicy) *admissionv1.AdmissionReview {
+		raw, err := json.Marshal(policy)
+		Expect(err).ToNot(HaveOccurred())
+		return &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Resource: metav1.GroupVersionResource{
+					Group:    migrationsv1.MigrationPolicyKind.Group,
+					Resource: migrations.ResourceMigrationPolicies,
+				},
+				Object: runtime.RawExtension{Raw: raw},
+			},
+		}
+	}
+
+	newMutator := func(kvConfig *v1.KubeVirtConfiguration) *MigrationPolicyMutator {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(kvConfig)
+		return NewMigrationPolicyMutator(clusterConfig)
+	}
+
+	It("does nothing when the MigrationPolicyDefaulting feature gate is disabled", func() {
+		mutator := newMutator(&v1.KubeVirtConfiguration{})
+		resp := mutator.Mutate(admissionReviewFor(&migrationsv1.MigrationPolicy{}))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Patch).To(BeNil())
+	})
+
+	It("defaults unset fields from the cluster's MigrationConfiguration", func() {
+		bandwidth := resource.MustParse("64Mi")
+		allowPostCopy := true
+		mutator := newMutator(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: []string{virtconfig.MigrationPolicyDefaulting},
+			},
+			MigrationConfiguration: &v1.MigrationConfiguration{
+				BandwidthPerMigration: &bandwidth,
+				AllowPostCopy:         &allowPostCopy,
+			},
+		})
+
+		resp := mutator.Mutate(admissionReviewFor(&migrationsv1.MigrationPolicy{}))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Patch).ToNot(BeNil())
+
+		var patches []map[string]interface{}
+		Expect(json.Unmarshal(resp.Patch, &patches)).To(Succeed())
+		paths := map[string]bool{}
+		for _, p := range patches {
+			Expect(p["op"]).To(Equal("add"))
+			paths[p["path"].(string)] = true
+		}
+		Expect(paths).To(HaveKey("/spec/bandwidthPerMigration"))
+		Expect(paths).To(HaveKey("/spec/allowPostCopy"))
+	})
+
+	It("leaves already-set fields untouched", func() {
+		existingBandwidth := resource.MustParse("1Mi")
+		defaultBandwidth := resource.MustParse("64Mi")
+		mutator := newMutator(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: []string{virtconfig.MigrationPolicyDefaulting},
+			},
+			MigrationConfiguration: &v1.MigrationConfiguration{
+				BandwidthPerMigration: &defaultBandwidth,
+			},
+		})
+
+		policy := &migrationsv1.MigrationPolicy{
+			Spec: migrationsv1.MigrationPolicySpec{
+				BandwidthPerMigration: &existingBandwidth,
+			},
+		}
+
+		resp := mutator.Mutate(admissionReviewFor(policy))
+		Expect(resp.Allowed).To(BeTrue())
+
+		// Other unset fields may still be defaulted; only the already-set one must not be patched.
+		var patches []map[string]interface{}
+		if resp.Patch != nil {
+			Expect(json.Unmarshal(resp.Patch, &patches)).To(Succeed())
+		}
+		for _, p := range patches {
+			Expect(p["path"]).ToNot(Equal("/spec/bandwidthPerMigration"))
+		}
+	})
+})
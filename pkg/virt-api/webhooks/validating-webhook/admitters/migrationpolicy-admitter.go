@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 
@@ -73,6 +74,14 @@ func (admitter *MigrationPolicyAdmitter) Admit(ar *admissionv1.AdmissionReview)
 	sourceField := k8sfield.NewPath("spec")
 
 	spec := policy.Spec
+	if ar.Request.Operation == admissionv1.Create && isEmptyMigrationPolicySpec(spec) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "spec must not be empty; the mutating webhook defaults an empty field, not an empty spec",
+			Field:   sourceField.String(),
+		})
+	}
+
 	if spec.CompletionTimeoutPerGiB != nil && *spec.CompletionTimeoutPerGiB < 0 {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
@@ -112,12 +121,210 @@ func (admitter *MigrationPolicyAdmitter) Admit(ar *admissionv1.AdmissionReview)
 		}
 	}
 
+	var warnings []string
+
+	if spec.AllowPostCopy != nil && *spec.AllowPostCopy && spec.AllowAutoConverge != nil && *spec.AllowAutoConverge {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "allowPostCopy and allowAutoConverge are mutually exclusive migration strategies",
+			Field:   sourceField.Child("allowPostCopy").String(),
+		})
+	}
+
+	postCopyDisabled := spec.AllowPostCopy == nil || !*spec.AllowPostCopy
+	if postCopyDisabled && spec.CompletionTimeoutPerGiB != nil && *spec.CompletionTimeoutPerGiB == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "completionTimeoutPerGiB must not be 0 when allowPostCopy is disabled, as the migration would never be cancelled",
+			Field:   sourceField.Child("completionTimeoutPerGiB").String(),
+		})
+	}
+
+	if spec.BandwidthPerMigration != nil && spec.BandwidthPerMigration.Sign() > 0 {
+		if floor := admitter.ClusterConfig.MigrationPolicyMinBandwidth(); spec.BandwidthPerMigration.Cmp(floor) < 0 {
+			warnings = append(warnings, fmt.Sprintf(
+				"bandwidthPerMigration below %s is rejected by QEMU and will have no effect", floor.String()))
+		}
+
+		if ceiling := admitter.ClusterConfig.MigrationPolicyMaxBandwidth(); !ceiling.IsZero() && spec.BandwidthPerMigration.Cmp(ceiling) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("must not exceed the cluster-configured maximum of %s", ceiling.String()),
+				Field:   sourceField.Child("bandwidthPerMigration").String(),
+			})
+		}
+	}
+
+	if ar.Request.Operation == admissionv1.Create || ar.Request.Operation == admissionv1.Update {
+		overlapCauses, overlapWarnings, err := admitter.checkSelectorOverlap(policy, sourceField)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+		causes = append(causes, overlapCauses...)
+		warnings = append(warnings, overlapWarnings...)
+	}
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
 	reviewResponse := admissionv1.AdmissionResponse{
-		Allowed: true,
+		Allowed:  true,
+		Warnings: warnings,
 	}
 	return &reviewResponse
 }
+
+// checkSelectorOverlap lists the other MigrationPolicy objects in the cluster and rejects the
+// admitted policy if its selectors are equal to, or a strict subset/superset of, another policy's
+// selectors at the same precedence tier. Selectors that can't be statically compared (i.e. they use
+// matchExpressions) only produce a warning, since evaluating them requires a live label set.
+func (admitter *MigrationPolicyAdmitter) checkSelectorOverlap(policy *migrationsv1.MigrationPolicy, sourceField *k8sfield.Path) ([]metav1.StatusCause, []string, error) {
+	var causes []metav1.StatusCause
+	var warnings []string
+
+	existingPolicies, err := admitter.Client.MigrationPolicy().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tier := policyPrecedenceTier(policy.Spec)
+	for _, existing := range existingPolicies.Items {
+		if existing.Name == policy.Name {
+			// Same object being updated; not an overlap with itself.
+			continue
+		}
+		if policyPrecedenceTier(existing.Spec) != tier {
+			continue
+		}
+
+		cmp := compareSelectors(policy.Spec.Selectors, existing.Spec.Selectors)
+		switch cmp {
+		case selectorsIncomparable:
+			warnings = append(warnings, fmt.Sprintf(
+				"MigrationPolicy %q uses a matchExpressions selector that could not be statically compared against %q; overlap could not be ruled out",
+				policy.Name, existing.Name))
+		case selectorsEqual, selectorsSubset, selectorsSuperset:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("selectors overlap with existing MigrationPolicy %q at the same precedence tier", existing.Name),
+				Field:   sourceField.Child("selectors").String(),
+			})
+		}
+	}
+
+	return causes, warnings, nil
+}
+
+// selectorOverlap describes the relationship between two MigrationPolicy selector sets.
+type selectorOverlap int
+
+const (
+	selectorsDisjoint selectorOverlap = iota
+	selectorsEqual
+	selectorsSubset
+	selectorsSuperset
+	selectorsIncomparable
+)
+
+// policyPrecedenceTier buckets a MigrationPolicy by selector specificity, mirroring the precedence
+// order used when merging overlapping policies: policies that constrain both the namespace and the
+// VMI take precedence over those that only constrain one, which in turn take precedence over those
+// that constrain neither.
+func policyPrecedenceTier(spec migrationsv1.MigrationPolicySpec) int {
+	tier := 0
+	if spec.Selectors == nil {
+		return tier
+	}
+	if spec.Selectors.NamespaceSelector != nil {
+		tier++
+	}
+	if spec.Selectors.VirtualMachineInstanceSelector != nil {
+		tier++
+	}
+	return tier
+}
+
+// compareSelectors compares the namespace and VMI selectors of two policies. It only handles plain
+// matchLabels selectors; if either selector uses matchExpressions the comparison is reported as
+// selectorsIncomparable rather than guessed at.
+func compareSelectors(a, b *migrationsv1.Selectors) selectorOverlap {
+	if a == nil || b == nil {
+		return selectorsDisjoint
+	}
+
+	nsCmp, nsOK := compareLabelSelector(a.NamespaceSelector, b.NamespaceSelector)
+	vmiCmp, vmiOK := compareLabelSelector(a.VirtualMachineInstanceSelector, b.VirtualMachineInstanceSelector)
+	if !nsOK || !vmiOK {
+		return selectorsIncomparable
+	}
+
+	if nsCmp == selectorsEqual && vmiCmp == selectorsEqual {
+		return selectorsEqual
+	}
+	if (nsCmp == selectorsEqual || nsCmp == selectorsSubset) && (vmiCmp == selectorsEqual || vmiCmp == selectorsSubset) {
+		return selectorsSubset
+	}
+	if (nsCmp == selectorsEqual || nsCmp == selectorsSuperset) && (vmiCmp == selectorsEqual || vmiCmp == selectorsSuperset) {
+		return selectorsSuperset
+	}
+	return selectorsDisjoint
+}
+
+// compareLabelSelector compares two matchLabels-only label selectors. The bool return is false when
+// either selector carries matchExpressions, since subset/superset can't be determined statically.
+func compareLabelSelector(a, b *metav1.LabelSelector) (selectorOverlap, bool) {
+	if a != nil && len(a.MatchExpressions) > 0 {
+		return selectorsDisjoint, false
+	}
+	if b != nil && len(b.MatchExpressions) > 0 {
+		return selectorsDisjoint, false
+	}
+
+	aLabels := map[string]string{}
+	if a != nil {
+		aLabels = a.MatchLabels
+	}
+	bLabels := map[string]string{}
+	if b != nil {
+		bLabels = b.MatchLabels
+	}
+
+	if reflect.DeepEqual(aLabels, bLabels) {
+		return selectorsEqual, true
+	}
+	if labelsSubsetOf(aLabels, bLabels) {
+		return selectorsSubset, true
+	}
+	if labelsSubsetOf(bLabels, aLabels) {
+		return selectorsSuperset, true
+	}
+	return selectorsDisjoint, true
+}
+
+// labelsSubsetOf returns true if sub is a strict subset of super: every key/value pair in sub is
+// also present in super, and super has more entries than sub. Equal label sets return false.
+func labelsSubsetOf(sub, super map[string]string) bool {
+	if len(sub) >= len(super) {
+		return false
+	}
+	for k, v := range sub {
+		if super[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// isEmptyMigrationPolicySpec reports whether none of a MigrationPolicy's fields were user-specified.
+// Such a policy would silently rely entirely on the mutating webhook's defaulting, which is more
+// likely to be an accidental empty manifest than an intentional "use cluster defaults" policy.
+func isEmptyMigrationPolicySpec(spec migrationsv1.MigrationPolicySpec) bool {
+	return spec.BandwidthPerMigration == nil &&
+		spec.CompletionTimeoutPerGiB == nil &&
+		spec.AllowPostCopy == nil &&
+		spec.AllowAutoConverge == nil &&
+		spec.ParallelMigrationsPerCluster == nil &&
+		spec.ParallelOutboundMigrationsPerNode == nil &&
+		spec.Selectors == nil
+}
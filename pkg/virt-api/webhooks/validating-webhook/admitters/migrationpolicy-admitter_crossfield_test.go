@@ -0,0 +1,188 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2026 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"os"
+
+	"go.uber.org/mock/gomock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	admissionv1 "k8s.io/api/admission/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/migrations"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("MigrationPolicyAdmitter cross-field validation", func() {
+	var (
+		ctrl       *gomock.Controller
+		virtClient *kubecli.MockKubevirtClient
+		admitter   *MigrationPolicyAdmitter
+	)
+
+	newAdmitter := func(kvConfig *v1.KubeVirtConfiguration) *MigrationPolicyAdmitter {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(kvConfig)
+		return NewMigrationPolicyAdmitter(clusterConfig, virtClient)
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+
+		migrationPolicyInterface := kubecli.NewMockMigrationPolicyInterface(ctrl)
+		migrationPolicyInterface.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return(&migrationsv1.MigrationPolicyList{}, nil).
+			AnyTimes()
+		virtClient.EXPECT().MigrationPolicy().Return(migrationPolicyInterface).AnyTimes()
+
+		fakeClient := fake.NewSimpleClientset(&k8sv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+		virtClient.EXPECT().CoreV1().Return(fakeClient.CoreV1()).AnyTimes()
+
+		admitter = newAdmitter(&v1.KubeVirtConfiguration{})
+	})
+
+	admissionReviewFor := func(policy *migrationsv1.MigrationPolicy, operation admissionv1.Operation) *admissionv1.AdmissionReview {
+		raw, err := json.Marshal(policy)
+		Expect(err).ToNot(HaveOccurred())
+		return &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: operation,
+				Resource: metav1.GroupVersionResource{
+					Group:    migrationsv1.MigrationPolicyKind.Group,
+					Resource: migrations.ResourceMigrationPolicies,
+				},
+				Object: runtime.RawExtension{Raw: raw},
+			},
+		}
+	}
+
+	It("rejects allowPostCopy and allowAutoConverge both true", func() {
+		allowTrue := true
+		policy := &migrationsv1.MigrationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+			Spec: migrationsv1.MigrationPolicySpec{
+				AllowPostCopy:     &allowTrue,
+				AllowAutoConverge: &allowTrue,
+			},
+		}
+
+		resp := admitter.Admit(admissionReviewFor(policy, admissionv1.Update))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.allowPostCopy"))
+	})
+
+	It("rejects a zero completionTimeoutPerGiB when allowPostCopy is disabled", func() {
+		allowFalse := false
+		zero := int64(0)
+		policy := &migrationsv1.MigrationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+			Spec: migrationsv1.MigrationPolicySpec{
+				AllowPostCopy:           &allowFalse,
+				CompletionTimeoutPerGiB: &zero,
+			},
+		}
+
+		resp := admitter.Admit(admissionReviewFor(policy, admissionv1.Update))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.completionTimeoutPerGiB"))
+	})
+
+	It("allows a zero completionTimeoutPerGiB when allowPostCopy is enabled", func() {
+		allowTrue := true
+		zero := int64(0)
+		policy := &migrationsv1.MigrationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+			Spec: migrationsv1.MigrationPolicySpec{
+				AllowPostCopy:           &allowTrue,
+				CompletionTimeoutPerGiB: &zero,
+			},
+		}
+
+		resp := admitter.Admit(admissionReviewFor(policy, admissionv1.Update))
+		if resp.Result != nil {
+			for _, cause := range resp.Result.Details.Causes {
+				Expect(cause.Field).ToNot(Equal("spec.completionTimeoutPerGiB"))
+			}
+		}
+	})
+
+	It("warns when bandwidthPerMigration is below the configured floor", func() {
+		belowFloor := resource.MustParse("512Ki")
+		policy := &migrationsv1.MigrationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+			Spec: migrationsv1.MigrationPolicySpec{
+				BandwidthPerMigration: &belowFloor,
+			},
+		}
+
+		resp := admitter.Admit(admissionReviewFor(policy, admissionv1.Update))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Warnings).To(ContainElement(ContainSubstring("bandwidthPerMigration")))
+	})
+
+	It("rejects bandwidthPerMigration above the configured ceiling", func() {
+		Expect(os.Setenv("MIGRATION_POLICY_MAX_BANDWIDTH", "10Mi")).To(Succeed())
+		defer os.Unsetenv("MIGRATION_POLICY_MAX_BANDWIDTH")
+
+		tooHigh := resource.MustParse("20Mi")
+		policy := &migrationsv1.MigrationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+			Spec: migrationsv1.MigrationPolicySpec{
+				BandwidthPerMigration: &tooHigh,
+			},
+		}
+
+		resp := admitter.Admit(admissionReviewFor(policy, admissionv1.Update))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.bandwidthPerMigration"))
+	})
+
+	It("allows any bandwidthPerMigration when no ceiling is configured", func() {
+		_, exists := os.LookupEnv("MIGRATION_POLICY_MAX_BANDWIDTH")
+		Expect(exists).To(BeFalse(), "test assumes MIGRATION_POLICY_MAX_BANDWIDTH is unset")
+
+		huge := resource.MustParse("10Gi")
+		policy := &migrationsv1.MigrationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+			Spec: migrationsv1.MigrationPolicySpec{
+				BandwidthPerMigration: &huge,
+			},
+		}
+
+		resp := admitter.Admit(admissionReviewFor(policy, admissionv1.Update))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+})
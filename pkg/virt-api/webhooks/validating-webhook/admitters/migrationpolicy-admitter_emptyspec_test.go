@@ -0,0 +1,44 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2026 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+)
+
+var _ = Describe("isEmptyMigrationPolicySpec", func() {
+	It("reports an entirely unset spec as empty", func() {
+		Expect(isEmptyMigrationPolicySpec(migrationsv1.MigrationPolicySpec{})).To(BeTrue())
+	})
+
+	It("does not report a spec with any field set as empty", func() {
+		allowPostCopy := true
+		spec := migrationsv1.MigrationPolicySpec{AllowPostCopy: &allowPostCopy}
+		Expect(isEmptyMigrationPolicySpec(spec)).To(BeFalse())
+	})
+
+	It("does not report a spec with only selectors set as empty", func() {
+		spec := migrationsv1.MigrationPolicySpec{Selectors: &migrationsv1.Selectors{}}
+		Expect(isEmptyMigrationPolicySpec(spec)).To(BeFalse())
+	})
+})
@@ -0,0 +1,83 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2026 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+)
+
+var _ = Describe("MigrationPolicy selector overlap", func() {
+	selector := func(matchLabels map[string]string) *metav1.LabelSelector {
+		return &metav1.LabelSelector{MatchLabels: matchLabels}
+	}
+
+	DescribeTable("compareSelectors", func(a, b *migrationsv1.Selectors, expected selectorOverlap) {
+		Expect(compareSelectors(a, b)).To(Equal(expected))
+	},
+		Entry("identical matchLabels selectors are equal",
+			&migrationsv1.Selectors{NamespaceSelector: selector(map[string]string{"env": "prod"})},
+			&migrationsv1.Selectors{NamespaceSelector: selector(map[string]string{"env": "prod"})},
+			selectorsEqual,
+		),
+		Entry("a's matchLabels being a literal superset of b's makes a the superset",
+			&migrationsv1.Selectors{NamespaceSelector: selector(map[string]string{"env": "prod", "team": "x"})},
+			&migrationsv1.Selectors{NamespaceSelector: selector(map[string]string{"env": "prod"})},
+			selectorsSuperset,
+		),
+		Entry("a's matchLabels being a literal subset of b's makes a the subset",
+			&migrationsv1.Selectors{NamespaceSelector: selector(map[string]string{"env": "prod"})},
+			&migrationsv1.Selectors{NamespaceSelector: selector(map[string]string{"env": "prod", "team": "x"})},
+			selectorsSubset,
+		),
+		Entry("disjoint matchLabels selectors don't overlap",
+			&migrationsv1.Selectors{NamespaceSelector: selector(map[string]string{"env": "prod"})},
+			&migrationsv1.Selectors{NamespaceSelector: selector(map[string]string{"env": "staging"})},
+			selectorsDisjoint,
+		),
+		Entry("a matchExpressions selector can't be statically compared",
+			&migrationsv1.Selectors{NamespaceSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: metav1.LabelSelectorOpExists}},
+			}},
+			&migrationsv1.Selectors{NamespaceSelector: selector(map[string]string{"env": "prod"})},
+			selectorsIncomparable,
+		),
+		Entry("nil selectors don't overlap", nil, &migrationsv1.Selectors{}, selectorsDisjoint),
+	)
+
+	DescribeTable("policyPrecedenceTier", func(spec migrationsv1.MigrationPolicySpec, expected int) {
+		Expect(policyPrecedenceTier(spec)).To(Equal(expected))
+	},
+		Entry("no selectors is tier 0", migrationsv1.MigrationPolicySpec{}, 0),
+		Entry("namespace selector only is tier 1",
+			migrationsv1.MigrationPolicySpec{Selectors: &migrationsv1.Selectors{NamespaceSelector: selector(nil)}}, 1),
+		Entry("vmi selector only is tier 1",
+			migrationsv1.MigrationPolicySpec{Selectors: &migrationsv1.Selectors{VirtualMachineInstanceSelector: selector(nil)}}, 1),
+		Entry("both selectors is tier 2",
+			migrationsv1.MigrationPolicySpec{Selectors: &migrationsv1.Selectors{
+				NamespaceSelector:              selector(nil),
+				VirtualMachineInstanceSelector: selector(nil),
+			}}, 2),
+	)
+})
@@ -0,0 +1,60 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2026 Red Hat, Inc.
+ *
+ */
+
+package virtconfig
+
+import (
+	"os"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// migrationPolicyMinBandwidthDefault is the floor QEMU actually honors; anything below it is
+// silently ignored by the migration, so the admission webhook warns rather than enforces it.
+var migrationPolicyMinBandwidthDefault = resource.MustParse("1Mi")
+
+// migrationPolicyMaxBandwidthEnvVar lets a cluster admin cap tenant-supplied MigrationPolicy
+// bandwidth without a dedicated KubeVirt CR field: neither bound has a home on the upstream
+// MigrationConfiguration type today, so both floor and ceiling are kept entirely on ClusterConfig
+// instead of being threaded through GetMigrationConfiguration().
+const migrationPolicyMaxBandwidthEnvVar = "MIGRATION_POLICY_MAX_BANDWIDTH"
+
+// MigrationPolicyMinBandwidth returns the floor below which a MigrationPolicy's
+// bandwidthPerMigration is flagged as having no effect. Fixed at 1Mi, the value QEMU itself
+// enforces.
+func (c *ClusterConfig) MigrationPolicyMinBandwidth() resource.Quantity {
+	return migrationPolicyMinBandwidthDefault
+}
+
+// MigrationPolicyMaxBandwidth returns the cluster-configured upper bound a tenant-supplied
+// MigrationPolicy's bandwidthPerMigration must not exceed, read from the
+// MIGRATION_POLICY_MAX_BANDWIDTH environment variable on virt-api. The zero value means no upper
+// bound is configured.
+func (c *ClusterConfig) MigrationPolicyMaxBandwidth() resource.Quantity {
+	value, exists := os.LookupEnv(migrationPolicyMaxBandwidthEnvVar)
+	if !exists {
+		return resource.Quantity{}
+	}
+
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return resource.Quantity{}
+	}
+	return quantity
+}
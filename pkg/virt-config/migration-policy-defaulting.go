@@ -0,0 +1,32 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2026 Red Hat, Inc.
+ *
+ */
+
+package virtconfig
+
+const (
+	// MigrationPolicyDefaulting enables the mutating webhook that defaults unset MigrationPolicy
+	// fields from the KubeVirt CR's MigrationConfiguration.
+	MigrationPolicyDefaulting = "MigrationPolicyDefaulting"
+)
+
+// MigrationPolicyDefaultingEnabled returns whether the MigrationPolicy mutating webhook should
+// default unset spec fields from the cluster's MigrationConfiguration.
+func (c *ClusterConfig) MigrationPolicyDefaultingEnabled() bool {
+	return c.FeatureGateEnabled(MigrationPolicyDefaulting)
+}